@@ -0,0 +1,237 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/commands/release/releaseutils/upload"
+
+	"gopkg.in/yaml.v3"
+)
+
+// expandGlobArgs expands any `**`-containing asset argument (e.g.
+// `./dist/**/*.tar.gz#label#type`) into one argument per matched file,
+// using doublestar-style recursive glob semantics that plain shell globbing
+// doesn't support. Arguments without `**` are passed through unchanged.
+func expandGlobArgs(args []string) ([]string, error) {
+	var expanded []string
+
+	for _, arg := range args {
+		assetPath, suffix := splitAssetArg(arg)
+		if !strings.Contains(assetPath, "**") {
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		matches, err := globRecursive(assetPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not expand glob %q: %w", assetPath, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files matched glob %q", assetPath)
+		}
+
+		for _, m := range matches {
+			expanded = append(expanded, m+suffix)
+		}
+	}
+
+	return expanded, nil
+}
+
+// splitAssetArg splits a `path#label#type` asset argument into its path and
+// its `#label#type` suffix, if any.
+func splitAssetArg(arg string) (assetPath, suffix string) {
+	if i := strings.Index(arg, "#"); i >= 0 {
+		return arg[:i], arg[i:]
+	}
+	return arg, ""
+}
+
+// globRecursive resolves a glob pattern that may contain `**` (meaning "any
+// number of directories") into the list of regular files it matches.
+func globRecursive(pattern string) ([]string, error) {
+	segments := strings.Split(filepath.ToSlash(pattern), "/")
+
+	var rootSegments, patternSegments []string
+	for i, seg := range segments {
+		if strings.ContainsAny(seg, "*?[") {
+			patternSegments = segments[i:]
+			break
+		}
+		rootSegments = append(rootSegments, seg)
+	}
+
+	root := strings.Join(rootSegments, "/")
+	if root == "" {
+		root = "."
+	}
+
+	var matches []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		if doublestarMatch(patternSegments, strings.Split(filepath.ToSlash(rel), "/")) {
+			matches = append(matches, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// doublestarMatch reports whether pathSegments matches patternSegments,
+// where a "**" segment matches zero or more path segments and any other
+// segment is matched with path.Match.
+func doublestarMatch(patternSegments, pathSegments []string) bool {
+	if len(patternSegments) == 0 {
+		return len(pathSegments) == 0
+	}
+
+	if patternSegments[0] == "**" {
+		for i := 0; i <= len(pathSegments); i++ {
+			if doublestarMatch(patternSegments[1:], pathSegments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(pathSegments) == 0 {
+		return false
+	}
+
+	if ok, err := path.Match(patternSegments[0], pathSegments[0]); err != nil || !ok {
+		return false
+	}
+
+	return doublestarMatch(patternSegments[1:], pathSegments[1:])
+}
+
+// assetManifestEntry is one entry of an --assets-manifest file.
+type assetManifestEntry struct {
+	Name            string `json:"name" yaml:"name"`
+	Path            string `json:"path" yaml:"path"`
+	LinkType        string `json:"link_type" yaml:"link_type"`
+	DirectAssetPath string `json:"direct_asset_path" yaml:"direct_asset_path"`
+	FilePath        string `json:"filepath" yaml:"filepath"`
+	URL             string `json:"url" yaml:"url"`
+}
+
+type assetsManifest struct {
+	Assets []assetManifestEntry `json:"assets" yaml:"assets"`
+}
+
+// loadAssetsManifest reads an --assets-manifest file (YAML or JSON, chosen
+// by extension) and splits its entries into files to upload and links to
+// pre-uploaded assets hosted elsewhere, based on whether a `url` is set.
+func loadAssetsManifest(manifestPath string) ([]*upload.ReleaseFile, []*upload.ReleaseAsset, error) {
+	b, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manifest assetsManifest
+	switch strings.ToLower(filepath.Ext(manifestPath)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &manifest)
+	default:
+		err = json.Unmarshal(b, &manifest)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var files []*upload.ReleaseFile
+	var links []*upload.ReleaseAsset
+
+	for _, a := range manifest.Assets {
+		if a.URL != "" {
+			links = append(links, &upload.ReleaseAsset{
+				Name:            a.Name,
+				URL:             a.URL,
+				LinkType:        a.LinkType,
+				DirectAssetPath: a.DirectAssetPath,
+			})
+			continue
+		}
+
+		assetPath := a.Path
+		if assetPath == "" {
+			assetPath = a.FilePath
+		}
+
+		files = append(files, &upload.ReleaseFile{
+			Name:            a.Name,
+			Path:            assetPath,
+			Type:            a.LinkType,
+			DirectAssetPath: a.DirectAssetPath,
+		})
+	}
+
+	return files, links, nil
+}
+
+// mergeReleaseFiles combines base and overlay, with overlay entries
+// replacing base entries of the same Name and otherwise being appended.
+func mergeReleaseFiles(base, overlay []*upload.ReleaseFile) []*upload.ReleaseFile {
+	result := make([]*upload.ReleaseFile, len(base))
+	copy(result, base)
+
+	indexByName := make(map[string]int, len(result))
+	for i, f := range result {
+		indexByName[f.Name] = i
+	}
+
+	for _, f := range overlay {
+		if i, ok := indexByName[f.Name]; ok {
+			result[i] = f
+			continue
+		}
+		indexByName[f.Name] = len(result)
+		result = append(result, f)
+	}
+
+	return result
+}
+
+// mergeReleaseAssets combines base and overlay, with overlay entries
+// replacing base entries of the same Name and otherwise being appended.
+func mergeReleaseAssets(base, overlay []*upload.ReleaseAsset) []*upload.ReleaseAsset {
+	result := make([]*upload.ReleaseAsset, len(base))
+	copy(result, base)
+
+	indexByName := make(map[string]int, len(result))
+	for i, a := range result {
+		indexByName[a.Name] = i
+	}
+
+	for _, a := range overlay {
+		if i, ok := indexByName[a.Name]; ok {
+			result[i] = a
+			continue
+		}
+		indexByName[a.Name] = len(result)
+		result = append(result, a)
+	}
+
+	return result
+}
@@ -7,7 +7,10 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
 	catalog "gitlab.com/gitlab-org/cli/commands/release/create/catalog"
@@ -44,6 +47,36 @@ type CreateOpts struct {
 	RepoOverride     string
 	PublishToCatalog bool
 
+	ChangelogFormat string
+	ChangelogFilter string
+	GenerateNotes   bool
+
+	ReleaseHeader        string
+	ReleaseFooter        string
+	ReleaseHeaderContent string
+	ReleaseFooterContent string
+
+	AutoMilestones bool
+	NotesFrom      string
+	LabelSection   string
+
+	Checksums []string
+	Sign      string
+	GPGKey    string
+
+	Draft      bool
+	Prerelease bool
+	Atomic     bool
+	DryRun     bool
+
+	AssetsManifest string
+	ExtraFiles     []string
+
+	// SignedAssets is populated by signReleaseAssets with the checksums/
+	// signature files it uploaded, so createRun can register them for
+	// --atomic rollback.
+	SignedAssets []*upload.ReleaseFile
+
 	NoteProvided       bool
 	ReleaseNotesAction string
 
@@ -90,6 +123,30 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			# Use release notes from a file
 			$ glab release create v1.0.1 -F changelog.md
 
+			# Generate release notes from Conventional Commits, grouped by type, non-interactively
+			$ glab release create v1.0.1 --generate-notes --changelog-format=conventional
+
+			# Generate release notes, excluding chores and docs commits
+			$ glab release create v1.0.1 --generate-notes --changelog-format=conventional --changelog-filter='!chore,!docs'
+
+			# Wrap the release notes with a shared banner and signoff
+			$ glab release create v1.0.1 --release-header banner.md --release-footer signoff.md
+
+			# Auto-associate milestones due in this release's range
+			$ glab release create v1.0.1 --auto-milestones
+
+			# Generate release notes from merged MRs instead of the commit log, grouped by label
+			$ glab release create v1.0.1 --notes-from=mrs --label-section='type::feature=Features,type::bug=Fixes'
+
+			# Upload a checksums file and sign every asset with cosign
+			$ glab release create v1.0.1 ./dist/* --checksums=sha256,sha512 --sign=cosign
+
+			# Create a draft prerelease, rolling back all side effects if any step fails
+			$ glab release create v1.0.1 --draft --prerelease --atomic
+
+			# Preview what a release would do without calling the API
+			$ glab release create v1.0.1 --dry-run
+
 			# Upload a release asset with a display name (type will default to 'other')
 			$ glab release create v1.0.1 '/path/to/asset.zip#My display label'
 
@@ -102,6 +159,15 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			# Upload all tarballs in a specified folder (types will default to 'other')
 			$ glab release create v1.0.1 ./dist/*.tar.gz
 
+			# Recursively upload every tarball under dist, however deeply nested
+			$ glab release create v1.0.1 './dist/**/*.tar.gz'
+
+			# Describe assets, including pre-uploaded ones, in a manifest file
+			$ glab release create v1.0.1 --assets-manifest release-assets.yaml
+
+			# Attach files that weren't produced by this pipeline, like an SBOM
+			$ glab release create v1.0.1 --extra-files sbom.json --extra-files LICENSE
+
 			# Create a release with assets specified as JSON object
 			$ glab release create v1.0.1 --assets-links='
 			  [
@@ -137,11 +203,24 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 
 			opts.TagName = args[0]
 
-			opts.AssetFiles, err = releaseutils.AssetsFromArgs(args[1:])
+			expandedArgs, err := expandGlobArgs(args[1:])
 			if err != nil {
 				return err
 			}
 
+			opts.AssetFiles, err = releaseutils.AssetsFromArgs(expandedArgs)
+			if err != nil {
+				return err
+			}
+
+			for _, path := range opts.ExtraFiles {
+				opts.AssetFiles = append(opts.AssetFiles, &upload.ReleaseFile{
+					Name: filepath.Base(path),
+					Path: path,
+					Type: "other",
+				})
+			}
+
 			if opts.AssetLinksAsJson != "" {
 				err := json.Unmarshal([]byte(opts.AssetLinksAsJson), &opts.AssetLinks)
 				if err != nil {
@@ -149,6 +228,15 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				}
 			}
 
+			if opts.AssetsManifest != "" {
+				manifestFiles, manifestLinks, err := loadAssetsManifest(opts.AssetsManifest)
+				if err != nil {
+					return fmt.Errorf("could not load --assets-manifest: %w", err)
+				}
+				opts.AssetFiles = mergeReleaseFiles(opts.AssetFiles, manifestFiles)
+				opts.AssetLinks = mergeReleaseAssets(opts.AssetLinks, manifestLinks)
+			}
+
 			opts.NoteProvided = cmd.Flags().Changed("notes")
 			if opts.NotesFile != "" {
 				var b []byte
@@ -168,6 +256,40 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				opts.NoteProvided = true
 			}
 
+			switch opts.Sign {
+			case "", "gpg", "cosign", "minisign":
+			default:
+				return fmt.Errorf("unsupported --sign: %q", opts.Sign)
+			}
+
+			for _, algo := range opts.Checksums {
+				switch algo {
+				case "sha256", "sha512":
+				default:
+					return fmt.Errorf("unsupported --checksums algorithm: %q", algo)
+				}
+			}
+
+			if opts.ReleaseHeader == "-" && opts.ReleaseFooter == "-" {
+				return fmt.Errorf("only one of --release-header and --release-footer can read from stdin")
+			}
+			if opts.NotesFile == "-" && (opts.ReleaseHeader == "-" || opts.ReleaseFooter == "-") {
+				return fmt.Errorf("--notes-file and --release-header/--release-footer cannot both read from stdin")
+			}
+
+			if opts.ReleaseHeader != "" {
+				opts.ReleaseHeaderContent, err = readNotesPart(opts.IO, opts.ReleaseHeader)
+				if err != nil {
+					return fmt.Errorf("could not read --release-header: %w", err)
+				}
+			}
+			if opts.ReleaseFooter != "" {
+				opts.ReleaseFooterContent, err = readNotesPart(opts.IO, opts.ReleaseFooter)
+				if err != nil {
+					return fmt.Errorf("could not read --release-footer: %w", err)
+				}
+			}
+
 			return createRun(opts)
 		},
 	}
@@ -181,11 +303,41 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&opts.Milestone, "milestone", "m", []string{}, "The title of each milestone the release is associated with.")
 	cmd.Flags().StringVarP(&opts.AssetLinksAsJson, "assets-links", "a", "", "'JSON' string representation of assets links, like `--assets-links='[{\"name\": \"Asset1\", \"url\":\"https://<domain>/some/location/1\", \"link_type\": \"other\", \"direct_asset_path\": \"path/to/file\"}]'.`")
 	cmd.Flags().BoolVar(&opts.PublishToCatalog, "publish-to-catalog", false, "[EXPERIMENTAL] Publish the release to the GitLab CI/CD catalog.")
+	cmd.Flags().StringVar(&opts.ChangelogFormat, "changelog-format", "plain", "Format to use for the generated changelog. One of 'plain', 'conventional', 'keep-a-changelog'.")
+	cmd.Flags().StringVar(&opts.ChangelogFilter, "changelog-filter", "", "Comma-separated list of Conventional Commits types to include, e.g. 'feat,fix,perf'. Prefix a type with '!' to exclude it instead.")
+	cmd.Flags().BoolVar(&opts.GenerateNotes, "generate-notes", false, "Generate release notes from the commit log (or from merged MRs with --notes-from=mrs) and use them non-interactively, skipping the notes prompt.")
+	cmd.Flags().StringVar(&opts.ReleaseHeader, "release-header", "", "Path to a 'file' whose contents are prepended to the release notes. Specify '-' to read from stdin. Supports templating with {{.Tag}}, {{.PreviousTag}}, {{.RepoURL}}, {{.Date}}, and {{.CompareURL}}.")
+	cmd.Flags().StringVar(&opts.ReleaseFooter, "release-footer", "", "Path to a 'file' whose contents are appended to the release notes. Specify '-' to read from stdin. Supports templating with {{.Tag}}, {{.PreviousTag}}, {{.RepoURL}}, {{.Date}}, and {{.CompareURL}}.")
+	cmd.Flags().BoolVar(&opts.AutoMilestones, "auto-milestones", false, "Associate the release with milestones due between the previous tag and this one. Ignored if --milestone is set.")
+	cmd.Flags().StringVar(&opts.NotesFrom, "notes-from", "", "Source to generate release notes from. One of '' (git log) or 'mrs' (merged merge requests, grouped by label). 'mrs' is an approximation: it lists MRs merged between the previous and current tag's commit dates, project-wide, not strictly MRs reachable from the commit range, so MRs merged to other branches in that window can appear.")
+	cmd.Flags().StringVar(&opts.LabelSection, "label-section", "", "Comma-separated 'label=Section' mapping used to group --notes-from=mrs entries, e.g. 'type::feature=Features,type::bug=Fixes'.")
+	cmd.Flags().StringSliceVar(&opts.Checksums, "checksums", nil, "Generate a 'checksums.txt' of the uploaded assets using the given algorithms, and upload it as a release asset. Supports 'sha256' and 'sha512'.")
+	cmd.Flags().StringVar(&opts.Sign, "sign", "", "Sign each uploaded asset (and the checksums file, if present) and upload the resulting signature alongside it. One of 'gpg', 'cosign', or 'minisign'.")
+	cmd.Flags().StringVar(&opts.GPGKey, "gpg-key", "", "The GPG key ID or email to sign with when --sign=gpg is set. Defaults to the signer's default key.")
+	cmd.Flags().BoolVar(&opts.Draft, "draft", false, "Create the release as a draft. Until --draft is cleared on a later update, the release is embargoed with a released_at far in the future.")
+	cmd.Flags().BoolVar(&opts.Prerelease, "prerelease", false, "Mark the release as a prerelease. GitLab has no dedicated prerelease field, so this only prepends a marker to the release notes.")
+	cmd.Flags().BoolVar(&opts.Atomic, "atomic", false, "If any step of the release fails, delete the release if this run created it, remove uploaded assets, and reopen closed milestones. Changes made by updating an existing release are not reverted.")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the planned API calls without executing them.")
+	cmd.Flags().StringVar(&opts.AssetsManifest, "assets-manifest", "", "Path to a YAML or JSON 'file' describing assets (name, path, link_type, direct_asset_path, filepath, url). Merges with --assets-links; manifest entries win on name collision.")
+	cmd.Flags().StringSliceVar(&opts.ExtraFiles, "extra-files", nil, "Additional 'file' paths to upload as release assets that weren't built by the current pipeline, e.g. SBOMs or license files.")
 
 	return cmd
 }
 
-func createRun(opts *CreateOpts) error {
+// readNotesPart reads the contents of a --release-header/--release-footer
+// flag value, which is either a file path or "-" to read from stdin.
+func readNotesPart(ios *iostreams.IOStreams, path string) (string, error) {
+	if path == "-" {
+		b, err := io.ReadAll(ios.In)
+		_ = ios.In.Close()
+		return string(b), err
+	}
+
+	b, err := os.ReadFile(path)
+	return string(b), err
+}
+
+func createRun(opts *CreateOpts) (err error) {
 	client, err := opts.HTTPClient()
 	if err != nil {
 		return err
@@ -199,6 +351,13 @@ func createRun(opts *CreateOpts) error {
 	var tag *gitlab.Tag
 	var resp *gitlab.Response
 
+	rollback := &rollbackStack{}
+	defer func() {
+		if err != nil && opts.Atomic {
+			rollback.unwind(opts.IO)
+		}
+	}()
+
 	if opts.Ref == "" {
 		opts.IO.Log(color.ProgressIcon(), "Validating tag", opts.TagName)
 		tag, resp, err = client.Tags.GetTag(repo.FullName(), opts.TagName)
@@ -218,6 +377,25 @@ func createRun(opts *CreateOpts) error {
 		opts.IO.Log()
 	}
 
+	if opts.GenerateNotes && !opts.NoteProvided {
+		headRef := opts.TagName
+		if opts.Ref != "" {
+			headRef = opts.Ref
+		}
+
+		// No previous tag (e.g. the first release of a project) isn't fatal:
+		// fall back to the full history. The interactive prompt below instead
+		// offers no commit-log template in that case.
+		prevTag, _ := detectPreviousTag(headRef)
+
+		var err error
+		opts.Notes, err = generateNotesForRange(opts, client, repo, prevTag, headRef)
+		if err != nil {
+			return fmt.Errorf("could not generate notes: %w", err)
+		}
+		opts.NoteProvided = true
+	}
+
 	if opts.IO.PromptEnabled() && !opts.NoteProvided {
 		editorCommand, err := cmdutils.GetEditor(opts.Config)
 		if err != nil {
@@ -247,8 +425,7 @@ func createRun(opts *CreateOpts) error {
 			}
 
 			if prevTag, err := detectPreviousTag(headRef); err == nil {
-				commits, _ := changelogForRange(fmt.Sprintf("%s..%s", prevTag, headRef))
-				generatedChangelog = generateChangelog(commits)
+				generatedChangelog, _ = generateNotesForRange(opts, client, repo, prevTag, headRef)
 			}
 		}
 
@@ -308,6 +485,23 @@ func createRun(opts *CreateOpts) error {
 			opts.Notes = txt
 		}
 	}
+
+	if opts.Prerelease && !strings.HasPrefix(opts.Notes, prereleaseNotesMarker) {
+		opts.Notes = prereleaseNotesMarker + opts.Notes
+	}
+
+	if opts.ReleaseHeaderContent != "" || opts.ReleaseFooterContent != "" {
+		opts.Notes, err = applyNotesTemplate(opts, repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.DryRun {
+		printDryRun(opts, repo)
+		return nil
+	}
+
 	start := time.Now()
 
 	opts.IO.Logf("%s Creating or updating release %s=%s %s=%s\n",
@@ -335,6 +529,29 @@ func createRun(opts *CreateOpts) error {
 		opts.Name = opts.TagName
 	}
 
+	if opts.Draft && opts.ReleasedAt == "" {
+		releasedAt = draftReleasedAt()
+	} else if !opts.Draft && opts.ReleasedAt == "" && release != nil && release.ReleasedAt != nil && release.ReleasedAt.After(draftThreshold()) {
+		// A previous run created this release as a draft; clearing --draft
+		// on this update un-embargoes it.
+		releasedAt = time.Now()
+	}
+
+	headRef := opts.TagName
+	if opts.Ref != "" {
+		headRef = opts.Ref
+	}
+
+	if len(opts.Milestone) == 0 && opts.AutoMilestones {
+		opts.IO.Log(color.ProgressIcon(), "Auto-associating milestones due in this release's range")
+		milestones, err := autoAssociateMilestones(opts, headRef)
+		if err != nil {
+			opts.IO.Log(color.DotWarnIcon(), fmt.Sprintf("could not auto-associate milestones: %s", err))
+		} else {
+			opts.Milestone = milestones
+		}
+	}
+
 	if (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) || release == nil {
 		createOpts := &gitlab.CreateReleaseOptions{
 			Name:    &opts.Name,
@@ -353,7 +570,7 @@ func createRun(opts *CreateOpts) error {
 			createOpts.TagMessage = &opts.TagMessage
 		}
 
-		if opts.ReleasedAt != "" {
+		if !releasedAt.IsZero() {
 			createOpts.ReleasedAt = &releasedAt
 		}
 
@@ -367,6 +584,12 @@ func createRun(opts *CreateOpts) error {
 		}
 		opts.IO.Logf("%s Release created:\t%s=%s\n", color.GreenCheck(),
 			color.Blue("url"), release.Links.Self)
+
+		releaseTagName := release.TagName
+		rollback.push(fmt.Sprintf("delete release %q", releaseTagName), func() error {
+			_, err := client.Releases.DeleteRelease(repo.FullName(), releaseTagName)
+			return err
+		})
 	} else {
 		updateOpts := &gitlab.UpdateReleaseOptions{
 			Name: &opts.Name,
@@ -375,7 +598,7 @@ func createRun(opts *CreateOpts) error {
 			updateOpts.Description = &opts.Notes
 		}
 
-		if opts.ReleasedAt != "" {
+		if !releasedAt.IsZero() {
 			updateOpts.ReleasedAt = &releasedAt
 		}
 
@@ -397,6 +620,22 @@ func createRun(opts *CreateOpts) error {
 	if err != nil {
 		return releaseFailedErr(err, start)
 	}
+	if len(opts.AssetFiles) > 0 || len(opts.AssetLinks) > 0 {
+		releaseTagName := release.TagName
+		rollback.push("unlink uploaded assets", func() error {
+			return unlinkReleaseAssets(client, repo, releaseTagName, opts.AssetFiles, opts.AssetLinks)
+		})
+	}
+
+	if err := signReleaseAssets(opts, client, repo, release.TagName); err != nil {
+		return releaseFailedErr(err, start)
+	}
+	if len(opts.SignedAssets) > 0 {
+		releaseTagName := release.TagName
+		rollback.push("unlink checksums/signature assets", func() error {
+			return unlinkReleaseAssets(client, repo, releaseTagName, opts.SignedAssets, nil)
+		})
+	}
 
 	if len(opts.Milestone) > 0 {
 		// close all associated milestones
@@ -409,8 +648,15 @@ func createRun(opts *CreateOpts) error {
 			opts.IO.StopSpinner("")
 			if err != nil {
 				opts.IO.Log(color.FailedIcon(), err.Error())
+				if opts.Atomic {
+					return releaseFailedErr(fmt.Errorf("could not close milestone %q: %w", milestone, err), start)
+				}
 			} else {
 				opts.IO.Logf("%s Closed milestone %q\n", color.GreenCheck(), milestone)
+				title := milestone
+				rollback.push(fmt.Sprintf("reopen milestone %q", title), func() error {
+					return reopenMilestone(opts, title)
+				})
 			}
 		}
 	}
@@ -430,6 +676,62 @@ func releaseFailedErr(err error, start time.Time) error {
 	return cmdutils.WrapError(err, fmt.Sprintf("release failed after %0.2fs.", time.Since(start).Seconds()))
 }
 
+// prereleaseNotesMarker is prepended to the release notes for --prerelease.
+// GitLab releases have no upstream prerelease flag, so this is a notes-only
+// signal: it's fragile across note regeneration and is applied before any
+// --release-header/--release-footer templating so the banner still wraps it.
+const prereleaseNotesMarker = "_Prerelease_\n\n"
+
+// draftReleasedAt returns a released_at timestamp far enough in the future
+// to keep a --draft release hidden until it's explicitly published.
+func draftReleasedAt() time.Time {
+	return time.Now().AddDate(draftEmbargoYears, 0, 0)
+}
+
+// draftThreshold is used to recognize a release that was previously created
+// with draftReleasedAt, so that clearing --draft on a later update can
+// un-embargo it.
+func draftThreshold() time.Time {
+	return time.Now().AddDate(draftEmbargoYears/2, 0, 0)
+}
+
+const draftEmbargoYears = 100
+
+// printDryRun prints the API calls createRun would have made, without
+// executing them.
+func printDryRun(opts *CreateOpts, repo glrepo.Interface) {
+	color := opts.IO.Color()
+	opts.IO.Logf("%s Dry run: the release was not created or updated.\n", color.ProgressIcon())
+	opts.IO.Logf("  %s repo=%s tag=%s ref=%s\n", color.Blue("release"), repo.FullName(), opts.TagName, opts.Ref)
+	if opts.Draft {
+		opts.IO.Log("  draft: release would be embargoed with a released_at far in the future")
+	}
+	if opts.Prerelease {
+		opts.IO.Log("  prerelease: notes would be marked as a prerelease")
+	}
+	if len(opts.Milestone) > 0 || opts.AutoMilestones {
+		opts.IO.Logf("  milestones: %v (auto=%v)\n", opts.Milestone, opts.AutoMilestones)
+	}
+	if len(opts.AssetFiles) > 0 {
+		opts.IO.Logf("  %d asset file(s) would be uploaded\n", len(opts.AssetFiles))
+	}
+	if len(opts.AssetLinks) > 0 {
+		opts.IO.Logf("  %d asset link(s) would be created\n", len(opts.AssetLinks))
+	}
+	if len(opts.Checksums) > 0 {
+		opts.IO.Logf("  checksums: %v\n", opts.Checksums)
+	}
+	if opts.Sign != "" {
+		opts.IO.Logf("  sign: %s\n", opts.Sign)
+	}
+	if opts.PublishToCatalog {
+		opts.IO.Log("  release would be published to the GitLab CI/CD catalog")
+	}
+	if opts.Atomic {
+		opts.IO.Log("  atomic: side effects would be rolled back on failure")
+	}
+}
+
 func getMilestoneByTitle(c *CreateOpts, title string) (*gitlab.Milestone, error) {
 	opts := &gitlab.ListMilestonesOptions{
 		Title: &title,
@@ -507,6 +809,71 @@ func closeMilestone(c *CreateOpts, title string) error {
 	return err
 }
 
+// autoAssociateMilestones returns the titles of milestones whose due date
+// falls between the previous tag and headRef, for use when --milestone is
+// omitted but --auto-milestones is set.
+func autoAssociateMilestones(opts *CreateOpts, headRef string) ([]string, error) {
+	prevTag, err := detectPreviousTag(headRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not detect previous tag: %w", err)
+	}
+
+	startDate, err := commitDate(prevTag)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine date of %q: %w", prevTag, err)
+	}
+
+	endDate, err := commitDate(headRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine date of %q: %w", headRef, err)
+	}
+
+	client, err := opts.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	var titles []string
+	listOpts := &gitlab.ListMilestonesOptions{}
+	for {
+		milestones, resp, err := client.Milestones.ListMilestones(repo.FullName(), listOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range milestones {
+			if m == nil || m.DueDate == nil {
+				continue
+			}
+			due := time.Time(*m.DueDate)
+			if !due.Before(startDate) && !due.After(endDate) {
+				titles = append(titles, m.Title)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	return titles, nil
+}
+
+func commitDate(ref string) (time.Time, error) {
+	cmd := git.GitCommand("log", "-1", "--format=%cI", ref)
+	b, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, strings.TrimSpace(string(b)))
+}
+
 func detectPreviousTag(headRef string) (string, error) {
 	cmd := git.GitCommand("describe", "--tags", "--abbrev=0", fmt.Sprintf("%s^", headRef))
 	b, err := run.PrepareCmd(cmd).Output()
@@ -514,12 +881,13 @@ func detectPreviousTag(headRef string) (string, error) {
 }
 
 type logEntry struct {
+	SHA     string
 	Subject string
 	Body    string
 }
 
 func changelogForRange(refRange string) ([]logEntry, error) {
-	cmd := git.GitCommand("-c", "log.ShowSignature=false", "log", "--first-parent", "--reverse", "--pretty=format:%B%x00", refRange)
+	cmd := git.GitCommand("-c", "log.ShowSignature=false", "log", "--first-parent", "--reverse", "--pretty=format:%h%x01%B%x00", refRange)
 
 	b, err := run.PrepareCmd(cmd).Output()
 	if err != nil {
@@ -533,13 +901,19 @@ func changelogForRange(refRange string) ([]logEntry, error) {
 		if c == "" {
 			continue
 		}
-		parts := strings.SplitN(c, "\n\n", 2)
+		header := strings.SplitN(c, "\x01", 2)
+		if len(header) != 2 {
+			continue
+		}
+		sha, rest := header[0], header[1]
+		parts := strings.SplitN(rest, "\n\n", 2)
 		var body string
 		subject := strings.ReplaceAll(parts[0], "\n", " ")
 		if len(parts) > 1 {
 			body = parts[1]
 		}
 		entries = append(entries, logEntry{
+			SHA:     sha,
 			Subject: subject,
 			Body:    body,
 		})
@@ -548,7 +922,223 @@ func changelogForRange(refRange string) ([]logEntry, error) {
 	return entries, nil
 }
 
-func generateChangelog(commits []logEntry) string {
+// conventionalCommitRE matches a Conventional Commits subject line, e.g.
+// "feat(api)!: add support for widgets".
+var conventionalCommitRE = regexp.MustCompile(`^([a-zA-Z]+)(\(([^)]+)\))?(!)?:\s*(.+)$`)
+
+const breakingChangeFooterPrefix = "BREAKING CHANGE:"
+
+type conventionalCommit struct {
+	logEntry
+	Type        string
+	Scope       string
+	Breaking    bool
+	Description string
+	Matched     bool
+}
+
+func parseConventionalCommit(c logEntry) conventionalCommit {
+	cc := conventionalCommit{logEntry: c, Description: c.Subject}
+
+	m := conventionalCommitRE.FindStringSubmatch(c.Subject)
+	if m == nil {
+		return cc
+	}
+
+	cc.Matched = true
+	cc.Type = strings.ToLower(m[1])
+	cc.Scope = m[3]
+	cc.Breaking = m[4] == "!"
+	cc.Description = m[5]
+
+	if strings.Contains(c.Body, breakingChangeFooterPrefix) {
+		cc.Breaking = true
+	}
+
+	return cc
+}
+
+// changelogFilter is the parsed form of the --changelog-filter flag, e.g.
+// "feat,fix,perf,!chore".
+type changelogFilter struct {
+	include map[string]bool
+	exclude map[string]bool
+}
+
+func parseChangelogFilter(filter string) changelogFilter {
+	cf := changelogFilter{include: map[string]bool{}, exclude: map[string]bool{}}
+	for _, t := range strings.Split(filter, ",") {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if strings.HasPrefix(t, "!") {
+			cf.exclude[strings.TrimPrefix(t, "!")] = true
+		} else {
+			cf.include[t] = true
+		}
+	}
+	return cf
+}
+
+func (cf changelogFilter) allows(commitType string) bool {
+	if cf.exclude[commitType] {
+		return false
+	}
+	if len(cf.include) > 0 {
+		return cf.include[commitType]
+	}
+	return true
+}
+
+var conventionalTypeHeadings = map[string]string{
+	"feat": "Features",
+	"fix":  "Bug Fixes",
+}
+
+var keepAChangelogHeadings = map[string]string{
+	"feat":     "Added",
+	"fix":      "Fixed",
+	"perf":     "Changed",
+	"refactor": "Changed",
+	"revert":   "Removed",
+	"security": "Security",
+}
+
+// generateChangelog renders commits as a markdown changelog in the requested
+// format. format is one of "plain", "conventional", or "keep-a-changelog".
+func generateChangelog(commits []logEntry, format, filter string) (string, error) {
+	switch format {
+	case "", "plain":
+		return generateChangelogPlain(commits), nil
+	case "conventional":
+		return generateChangelogGrouped(commits, filter, conventionalTypeHeadings, "Other"), nil
+	case "keep-a-changelog":
+		return generateChangelogGrouped(commits, filter, keepAChangelogHeadings, "Other"), nil
+	default:
+		return "", fmt.Errorf("unsupported --changelog-format: %q", format)
+	}
+}
+
+// generateNotesForRange produces release notes for the range prevTag..headRef,
+// sourcing them either from the git log (the default) or, when
+// opts.NotesFrom is "mrs", from merged merge requests in that range.
+// generateNotesForRange sources notes for everything up to and including
+// headRef. prevTag may be "" when headRef has no earlier tag (e.g. the
+// first release of a project), in which case the full history is used.
+func generateNotesForRange(opts *CreateOpts, client *gitlab.Client, repo glrepo.Interface, prevTag, headRef string) (string, error) {
+	switch opts.NotesFrom {
+	case "", "commits":
+		notesRange := headRef
+		if prevTag != "" {
+			notesRange = fmt.Sprintf("%s..%s", prevTag, headRef)
+		}
+		commits, err := changelogForRange(notesRange)
+		if err != nil {
+			return "", err
+		}
+		return generateChangelog(commits, opts.ChangelogFormat, opts.ChangelogFilter)
+	case "mrs":
+		var startDate time.Time
+		if prevTag != "" {
+			var err error
+			startDate, err = commitDate(prevTag)
+			if err != nil {
+				return "", fmt.Errorf("could not determine date of %q: %w", prevTag, err)
+			}
+		}
+		endDate, err := commitDate(headRef)
+		if err != nil {
+			return "", fmt.Errorf("could not determine date of %q: %w", headRef, err)
+		}
+		return changelogFromMergedMRs(client, repo, startDate, endDate, opts.LabelSection)
+	default:
+		return "", fmt.Errorf("unsupported --notes-from: %q", opts.NotesFrom)
+	}
+}
+
+// parseLabelSections parses the --label-section flag, e.g.
+// "type::feature=Features,type::bug=Fixes", into a label -> section map.
+func parseLabelSections(mapping string) map[string]string {
+	sections := map[string]string{}
+	for _, pair := range strings.Split(mapping, ",") {
+		label, section, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		sections[strings.TrimSpace(label)] = strings.TrimSpace(section)
+	}
+	return sections
+}
+
+const mrChangelogOtherSection = "Other"
+
+// changelogFromMergedMRs renders a markdown changelog from merge requests
+// merged between startDate and endDate, grouped by label using
+// labelSectionMapping (see parseLabelSections).
+//
+// This is an approximation of "MRs in the commit range": GitLab has no API
+// to list MRs by commit range directly, so this lists merged MRs
+// project-wide by merge date instead. MRs merged to unrelated branches
+// within the window can be included, and MRs merged outside the window
+// whose commits nonetheless landed in range can be missed.
+func changelogFromMergedMRs(client *gitlab.Client, repo glrepo.Interface, startDate, endDate time.Time, labelSectionMapping string) (string, error) {
+	sectionsByLabel := parseLabelSections(labelSectionMapping)
+
+	state := "merged"
+	listOpts := &gitlab.ListProjectMergeRequestsOptions{
+		State:        &state,
+		UpdatedAfter: &startDate,
+	}
+
+	sections := map[string][]string{}
+	var order []string
+
+	for {
+		mrs, resp, err := client.MergeRequests.ListProjectMergeRequests(repo.FullName(), listOpts)
+		if err != nil {
+			return "", err
+		}
+
+		for _, mr := range mrs {
+			if mr == nil || mr.MergedAt == nil || mr.MergedAt.After(endDate) || mr.MergedAt.Before(startDate) {
+				continue
+			}
+
+			section := mrChangelogOtherSection
+			for _, label := range mr.Labels {
+				if s, ok := sectionsByLabel[label]; ok {
+					section = s
+					break
+				}
+			}
+
+			if _, seen := sections[section]; !seen {
+				order = append(order, section)
+			}
+
+			author := "ghost"
+			if mr.Author != nil {
+				author = mr.Author.Username
+			}
+			sections[section] = append(sections[section], fmt.Sprintf("* %s (!%d) by @%s", mr.Title, mr.IID, author))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	var parts []string
+	for _, section := range order {
+		parts = append(parts, fmt.Sprintf("### %s\n\n%s", section, strings.Join(sections[section], "\n")))
+	}
+
+	return strings.Join(parts, "\n\n"), nil
+}
+
+func generateChangelogPlain(commits []logEntry) string {
 	var parts []string
 	for _, c := range commits {
 		parts = append(parts, fmt.Sprintf("* %s", c.Subject))
@@ -558,3 +1148,134 @@ func generateChangelog(commits []logEntry) string {
 	}
 	return strings.Join(parts, "\n\n")
 }
+
+// generateChangelogGrouped renders commits into markdown sections keyed by
+// headings, with unmatched/unknown types falling into otherHeading and
+// breaking changes always surfaced in their own leading section.
+func generateChangelogGrouped(commits []logEntry, filter string, headings map[string]string, otherHeading string) string {
+	cf := parseChangelogFilter(filter)
+
+	sections := []string{"Breaking Changes"}
+	seen := map[string]bool{sections[0]: true}
+	for _, h := range headings {
+		if !seen[h] {
+			seen[h] = true
+			sections = append(sections, h)
+		}
+	}
+	sections = append(sections, otherHeading)
+
+	entries := map[string][]string{}
+	seenSubjects := map[string]bool{}
+
+	for _, c := range commits {
+		cc := parseConventionalCommit(c)
+
+		if !cc.Breaking && cc.Matched && !cf.allows(cc.Type) {
+			continue
+		}
+
+		if seenSubjects[cc.Description] {
+			continue
+		}
+		seenSubjects[cc.Description] = true
+
+		line := fmt.Sprintf("* %s (%s)", cc.Description, shortSHA(cc.SHA))
+
+		if cc.Breaking {
+			entries["Breaking Changes"] = append(entries["Breaking Changes"], line)
+			continue
+		}
+
+		heading, ok := headings[cc.Type]
+		if !ok {
+			heading = otherHeading
+		}
+		entries[heading] = append(entries[heading], line)
+	}
+
+	var parts []string
+	for _, section := range sections {
+		lines := entries[section]
+		if len(lines) == 0 {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("### %s\n\n%s", section, strings.Join(lines, "\n")))
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// notesTemplateData is the set of variables available to a
+// --release-header/--release-footer file.
+type notesTemplateData struct {
+	Tag         string
+	PreviousTag string
+	RepoURL     string
+	Date        string
+	CompareURL  string
+}
+
+// applyNotesTemplate renders the configured --release-header and
+// --release-footer files and wraps them around opts.Notes.
+func applyNotesTemplate(opts *CreateOpts, repo glrepo.Interface) (string, error) {
+	repoURL := fmt.Sprintf("https://%s/%s", repo.RepoHost(), repo.FullName())
+	previousTag, _ := detectPreviousTag(opts.TagName)
+
+	data := notesTemplateData{
+		Tag:         opts.TagName,
+		PreviousTag: previousTag,
+		RepoURL:     repoURL,
+		Date:        time.Now().UTC().Format("2006-01-02"),
+	}
+	if previousTag != "" {
+		data.CompareURL = fmt.Sprintf("%s/-/compare/%s...%s", repoURL, previousTag, opts.TagName)
+	}
+
+	header, err := renderNotesTemplate(opts.ReleaseHeaderContent, data)
+	if err != nil {
+		return "", fmt.Errorf("could not render --release-header: %w", err)
+	}
+	footer, err := renderNotesTemplate(opts.ReleaseFooterContent, data)
+	if err != nil {
+		return "", fmt.Errorf("could not render --release-footer: %w", err)
+	}
+
+	var parts []string
+	if header != "" {
+		parts = append(parts, strings.TrimRight(header, "\n"))
+	}
+	if opts.Notes != "" {
+		parts = append(parts, opts.Notes)
+	}
+	if footer != "" {
+		parts = append(parts, strings.TrimRight(footer, "\n"))
+	}
+
+	return strings.Join(parts, "\n\n"), nil
+}
+
+func renderNotesTemplate(content string, data notesTemplateData) (string, error) {
+	if content == "" {
+		return "", nil
+	}
+
+	tmpl, err := template.New("notes").Parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
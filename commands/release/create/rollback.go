@@ -0,0 +1,103 @@
+package create
+
+import (
+	"fmt"
+
+	"gitlab.com/gitlab-org/cli/commands/release/releaseutils/upload"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// rollbackStep is one side effect of a release run that can be undone if a
+// later step fails and --atomic is set.
+type rollbackStep struct {
+	describe string
+	undo     func() error
+}
+
+// rollbackStack records side effects in the order they happened so they can
+// be unwound last-in-first-out when createRun fails partway through.
+type rollbackStack struct {
+	steps []rollbackStep
+}
+
+func (s *rollbackStack) push(describe string, undo func() error) {
+	s.steps = append(s.steps, rollbackStep{describe: describe, undo: undo})
+}
+
+func (s *rollbackStack) unwind(io *iostreams.IOStreams) {
+	color := io.Color()
+	for i := len(s.steps) - 1; i >= 0; i-- {
+		step := s.steps[i]
+		io.Log(color.ProgressIcon(), "Rolling back:", step.describe)
+		if err := step.undo(); err != nil {
+			io.Log(color.FailedIcon(), fmt.Sprintf("could not roll back %q: %s", step.describe, err))
+		}
+	}
+}
+
+// reopenMilestone reverses closeMilestone.
+func reopenMilestone(c *CreateOpts, title string) error {
+	client, err := c.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := c.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	milestone, err := getMilestoneByTitle(c, title)
+	if err != nil {
+		return err
+	}
+	if milestone == nil {
+		return fmt.Errorf("could not find milestone: %q", title)
+	}
+
+	reopenStateEvent := "activate"
+
+	_, _, err = client.Milestones.UpdateMilestone(repo.FullName(), milestone.ID, &gitlab.UpdateMilestoneOptions{
+		Description: &milestone.Description,
+		DueDate:     milestone.DueDate,
+		StartDate:   milestone.StartDate,
+		StateEvent:  &reopenStateEvent,
+		Title:       &milestone.Title,
+	})
+
+	return err
+}
+
+// unlinkReleaseAssets deletes the release links that this run uploaded, for
+// use when rolling back a partially-completed release.
+func unlinkReleaseAssets(client *gitlab.Client, repo glrepo.Interface, tagName string, files []*upload.ReleaseFile, links []*upload.ReleaseAsset) error {
+	uploaded := map[string]bool{}
+	for _, f := range files {
+		uploaded[f.Name] = true
+	}
+	for _, l := range links {
+		uploaded[l.Name] = true
+	}
+	if len(uploaded) == 0 {
+		return nil
+	}
+
+	releaseLinks, _, err := client.ReleaseLinks.ListReleaseLinks(repo.FullName(), tagName, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range releaseLinks {
+		if link == nil || !uploaded[link.Name] {
+			continue
+		}
+		if _, _, err := client.ReleaseLinks.DeleteReleaseLink(repo.FullName(), tagName, link.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
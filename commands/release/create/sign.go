@@ -0,0 +1,195 @@
+package create
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"gitlab.com/gitlab-org/cli/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/commands/release/releaseutils/upload"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/run"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// signReleaseAssets generates a checksums file for the uploaded assets when
+// --checksums is set, signs the assets (and the checksums file) when --sign
+// is set, and uploads the resulting files as additional release assets.
+func signReleaseAssets(opts *CreateOpts, client *gitlab.Client, repo glrepo.Interface, tagName string) error {
+	if len(opts.Checksums) == 0 && opts.Sign == "" {
+		return nil
+	}
+
+	var toUpload []*upload.ReleaseFile
+
+	if len(opts.Checksums) > 0 {
+		checksumsPath, err := writeChecksumsFile(opts.AssetFiles, opts.Checksums)
+		if err != nil {
+			return fmt.Errorf("could not generate checksums: %w", err)
+		}
+		defer os.Remove(checksumsPath)
+
+		toUpload = append(toUpload, &upload.ReleaseFile{
+			Name:            "checksums.txt",
+			Path:            checksumsPath,
+			Type:            "other",
+			DirectAssetPath: "checksums.txt",
+		})
+	}
+
+	if opts.Sign != "" {
+		toSign := append([]*upload.ReleaseFile{}, opts.AssetFiles...)
+		toSign = append(toSign, toUpload...)
+
+		for _, f := range toSign {
+			sigPath, err := signFile(f.Path, opts.Sign, opts.GPGKey)
+			if err != nil {
+				return fmt.Errorf("could not sign %q: %w", f.Path, err)
+			}
+			defer os.Remove(sigPath)
+
+			sigExt := filepath.Ext(sigPath)
+			toUpload = append(toUpload, &upload.ReleaseFile{
+				Name:            filepath.Base(assetDirectAssetPath(f)) + sigExt,
+				Path:            sigPath,
+				Type:            "other",
+				DirectAssetPath: assetDirectAssetPath(f) + sigExt,
+			})
+		}
+	}
+
+	if len(toUpload) == 0 {
+		return nil
+	}
+
+	if err := releaseutils.CreateReleaseAssets(opts.IO, client, toUpload, nil, repo.FullName(), tagName); err != nil {
+		return err
+	}
+
+	opts.SignedAssets = toUpload
+
+	return nil
+}
+
+// assetDirectAssetPath returns the path a signature's direct_asset_path
+// should mirror: the asset's own direct_asset_path if it has one, falling
+// back to its name (or base path for assets the user didn't label).
+func assetDirectAssetPath(f *upload.ReleaseFile) string {
+	if f.DirectAssetPath != "" {
+		return f.DirectAssetPath
+	}
+	if f.Name != "" {
+		return f.Name
+	}
+	return filepath.Base(f.Path)
+}
+
+// writeChecksumsFile hashes each of files with every requested algorithm and
+// writes the results, one line per file per algorithm, to a checksums.txt
+// in the style of `sha256sum`/`sha512sum` output.
+func writeChecksumsFile(files []*upload.ReleaseFile, algorithms []string) (string, error) {
+	tmp, err := os.CreateTemp("", "checksums-*.txt")
+	if err != nil {
+		return "", err
+	}
+	defer tmp.Close()
+
+	for _, algo := range algorithms {
+		for _, f := range files {
+			sum, err := hashFile(f.Path, algo)
+			if err != nil {
+				return "", err
+			}
+			if _, err := fmt.Fprintf(tmp, "%s  %s\n", sum, filepath.Base(f.Path)); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return tmp.Name(), nil
+}
+
+func hashFile(path, algorithm string) (string, error) {
+	var h hash.Hash
+	switch algorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("unsupported --checksums algorithm: %q", algorithm)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// signFile signs path using the given method ("gpg", "cosign", or
+// "minisign") and returns the path to the detached signature it produced.
+func signFile(path, method, gpgKey string) (string, error) {
+	switch method {
+	case "gpg":
+		return signFileGPG(path, gpgKey)
+	case "cosign":
+		return signFileCosign(path)
+	case "minisign":
+		return signFileMinisign(path)
+	default:
+		return "", fmt.Errorf("unsupported --sign method: %q", method)
+	}
+}
+
+func signFileGPG(path, gpgKey string) (string, error) {
+	sigPath := path + ".asc"
+	args := []string{"--batch", "--yes", "--armor", "--detach-sign", "--output", sigPath}
+	if gpgKey != "" {
+		args = append(args, "--local-user", gpgKey)
+	}
+	args = append(args, path)
+
+	if _, err := run.PrepareCmd(exec.Command("gpg", args...)).Output(); err != nil {
+		return "", err
+	}
+	return sigPath, nil
+}
+
+func signFileCosign(path string) (string, error) {
+	sigPath := path + ".sig"
+	cmd := exec.Command("cosign", "sign-blob",
+		"--key", "env://COSIGN_KEY",
+		"--output-signature", sigPath,
+		"--yes",
+		path,
+	)
+	cmd.Env = os.Environ()
+
+	if _, err := run.PrepareCmd(cmd).Output(); err != nil {
+		return "", err
+	}
+	return sigPath, nil
+}
+
+func signFileMinisign(path string) (string, error) {
+	sigPath := path + ".minisig"
+	cmd := exec.Command("minisign", "-S", "-s", os.Getenv("MINISIGN_KEY"), "-m", path, "-x", sigPath)
+
+	if _, err := run.PrepareCmd(cmd).Output(); err != nil {
+		return "", err
+	}
+	return sigPath, nil
+}